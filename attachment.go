@@ -0,0 +1,46 @@
+package autosend
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+)
+
+// AttachFile reads the file at path and appends it to the request's
+// attachments, deriving the content type from the file extension.
+func (req *SendEmailRequest) AttachFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %q: %w", path, err)
+	}
+
+	ctype := mime.TypeByExtension(filepath.Ext(path))
+	if ctype == "" {
+		ctype = "application/octet-stream"
+	}
+
+	req.Attachments = append(req.Attachments, Attachment{
+		Filename:    filepath.Base(path),
+		ContentType: ctype,
+		Content:     content,
+	})
+	return nil
+}
+
+// AttachReader reads r to completion and appends it to the request's
+// attachments under the given filename and content type.
+func (req *SendEmailRequest) AttachReader(name, ctype string, r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %q: %w", name, err)
+	}
+
+	req.Attachments = append(req.Attachments, Attachment{
+		Filename:    name,
+		ContentType: ctype,
+		Content:     content,
+	})
+	return nil
+}
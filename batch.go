@@ -0,0 +1,116 @@
+package autosend
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of in-flight requests SendEmailBatch
+// uses when BatchOptions.Concurrency is left at zero.
+const DefaultBatchConcurrency = 8
+
+// BatchOptions configures SendEmailBatch and SendEmailBatchStream.
+type BatchOptions struct {
+	// Concurrency is the number of requests sent in parallel. Defaults to
+	// DefaultBatchConcurrency when zero or negative.
+	Concurrency int
+
+	// IdempotencyKeyFn, when set, derives an idempotency key for each
+	// request from its index and value. Leave nil to send without one.
+	IdempotencyKeyFn func(req *SendEmailRequest, index int) string
+}
+
+// BatchResult is the outcome of sending a single message as part of a batch.
+type BatchResult struct {
+	Index          int
+	Response       *SendEmailResponse
+	Err            error
+	IdempotencyKey string
+}
+
+// SendEmailBatch sends reqs concurrently through a bounded worker pool and
+// returns one BatchResult per request, in the same order as reqs. A failure
+// sending one message does not prevent the others from being sent; check
+// each result's Err field. If ctx is canceled before all requests have been
+// dispatched, the remaining results carry ctx.Err().
+func (c *Client) SendEmailBatch(ctx context.Context, reqs []*SendEmailRequest, opts BatchOptions) ([]BatchResult, error) {
+	ch, err := c.SendEmailBatchStream(ctx, reqs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, len(reqs))
+	for i := range results {
+		results[i].Index = i
+	}
+	for res := range ch {
+		results[res.Index] = res
+	}
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			if results[i].Response == nil && results[i].Err == nil {
+				results[i].Err = err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// SendEmailBatchStream is the streaming form of SendEmailBatch. It returns a
+// channel that receives one BatchResult per request as sends complete, in
+// completion order rather than input order, so callers can report progress
+// while a batch is in flight. The channel is closed once every request has
+// been attempted or ctx is canceled and in-flight requests have drained.
+func (c *Client) SendEmailBatchStream(ctx context.Context, reqs []*SendEmailRequest, opts BatchOptions) (<-chan BatchResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	jobs := make(chan int)
+	results := make(chan BatchResult)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				req := reqs[idx]
+
+				var key string
+				if opts.IdempotencyKeyFn != nil {
+					key = opts.IdempotencyKeyFn(req, idx)
+				}
+
+				resp, err := c.SendEmailWithIdempotency(ctx, req, key)
+				results <- BatchResult{
+					Index:          idx,
+					Response:       resp,
+					Err:            err,
+					IdempotencyKey: key,
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := range reqs {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results, nil
+}
@@ -2,12 +2,10 @@ package autosend
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 )
 
-// SendEmail sends an email using the Autosend API.
+// SendEmail sends an email using the client's configured Transport.
 // It returns the response data or an error if the request fails.
 func (c *Client) SendEmail(ctx context.Context, req *SendEmailRequest) (*SendEmailResponse, error) {
 	return c.SendEmailWithIdempotency(ctx, req, "")
@@ -17,32 +15,13 @@ func (c *Client) SendEmail(ctx context.Context, req *SendEmailRequest) (*SendEma
 // The idempotency key allows you to safely retry requests without sending duplicate emails.
 // If you retry a request with the same idempotency key within 24 hours,
 // you'll receive the same response without sending a duplicate email.
+// Transports other than HTTPTransport may ignore the idempotency key.
 func (c *Client) SendEmailWithIdempotency(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error) {
 	if err := validateSendEmailRequest(req); err != nil {
 		return nil, err
 	}
 
-	resp, err := c.doRequest(ctx, "POST", "/mails/send", req, idempotencyKey)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, handleErrorResponse(resp)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var emailResp SendEmailResponse
-	if err := json.Unmarshal(body, &emailResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &emailResp, nil
+	return c.transport.Send(ctx, req, idempotencyKey)
 }
 
 // validateSendEmailRequest validates the required fields in the send email request.
@@ -51,8 +30,13 @@ func validateSendEmailRequest(req *SendEmailRequest) error {
 		return fmt.Errorf("request cannot be nil")
 	}
 
-	if req.To.Email == "" {
-		return fmt.Errorf("to.email is required")
+	if len(req.To) == 0 {
+		return fmt.Errorf("at least one recipient (to) is required")
+	}
+	for i, to := range req.To {
+		if to.Email == "" {
+			return fmt.Errorf("to[%d].email is required", i)
+		}
 	}
 
 	if req.From.Email == "" {
@@ -69,5 +53,14 @@ func validateSendEmailRequest(req *SendEmailRequest) error {
 		return fmt.Errorf("subject is required when not using a template")
 	}
 
+	for i, att := range req.Attachments {
+		if att.Filename == "" {
+			return fmt.Errorf("attachments[%d].filename is required", i)
+		}
+		if len(att.Content) > MaxAttachmentSize {
+			return fmt.Errorf("attachments[%d] %q exceeds max attachment size of %d bytes", i, att.Filename, MaxAttachmentSize)
+		}
+	}
+
 	return nil
 }
@@ -20,9 +20,11 @@ func main() {
 	client := autosend.NewClient(apiKey)
 
 	req := &autosend.SendEmailRequest{
-		To: autosend.EmailAddress{
-			Email: "customer@example.com",
-			Name:  "Jane Smith",
+		To: []autosend.EmailAddress{
+			{
+				Email: "customer@example.com",
+				Name:  "Jane Smith",
+			},
 		},
 		From: autosend.EmailAddress{
 			Email: "hello@mail.yourdomain.com",
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/dapoadedire/autosend-go/webhook"
+)
+
+func main() {
+	secret := os.Getenv("AUTOSEND_WEBHOOK_SECRET")
+	if secret == "" {
+		log.Fatal("AUTOSEND_WEBHOOK_SECRET environment variable is required")
+	}
+
+	h := webhook.NewHandler(secret)
+
+	h.OnDelivered(func(ctx context.Context, ev *webhook.DeliveredEvent) error {
+		log.Printf("delivered: %s", ev.EmailID)
+		return nil
+	})
+
+	h.OnBounced(func(ctx context.Context, ev *webhook.BouncedEvent) error {
+		log.Printf("bounced: %s (%s: %s)", ev.EmailID, ev.BounceType, ev.Reason)
+		return nil
+	})
+
+	h.OnComplained(func(ctx context.Context, ev *webhook.ComplainedEvent) error {
+		log.Printf("complained: %s", ev.EmailID)
+		return nil
+	})
+
+	h.OnUnknown(func(ctx context.Context, ev *webhook.RawEvent) error {
+		log.Printf("unhandled event type %q for %s", ev.Type, ev.EmailID)
+		return nil
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks/autosend", h)
+
+	log.Fatal(http.ListenAndServe(":8080", mux))
+}
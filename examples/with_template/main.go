@@ -21,9 +21,11 @@ func main() {
 
 	// Prepare email request with template
 	req := &autosend.SendEmailRequest{
-		To: autosend.EmailAddress{
-			Email: "customer@example.com",
-			Name:  "Jane Smith",
+		To: []autosend.EmailAddress{
+			{
+				Email: "customer@example.com",
+				Name:  "Jane Smith",
+			},
 		},
 		From: autosend.EmailAddress{
 			Email: "hello@mail.yourdomain.com",
@@ -0,0 +1,49 @@
+package autosend
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileTransport writes each message as an .eml file to a directory instead
+// of sending it, for local development and debugging.
+type FileTransport struct {
+	Dir string
+}
+
+// NewFileTransport creates a FileTransport that writes messages to dir,
+// creating it if necessary.
+func NewFileTransport(dir string) *FileTransport {
+	return &FileTransport{Dir: dir}
+}
+
+// Send implements Transport by writing req as a MIME message to a new .eml
+// file in t.Dir, named after idempotencyKey when set.
+func (t *FileTransport) Send(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error) {
+	if err := os.MkdirAll(t.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", t.Dir, err)
+	}
+
+	msg, err := buildMIMEMessage(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build MIME message: %w", err)
+	}
+
+	name := idempotencyKey
+	if name == "" {
+		name = fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+
+	path := filepath.Join(t.Dir, name+".eml")
+	if err := os.WriteFile(path, msg, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return &SendEmailResponse{
+		Success: true,
+		Message: fmt.Sprintf("written to %s", path),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package autosend
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+)
+
+// buildMIMEMessage renders req as an RFC 5322 message with a
+// multipart/alternative text+HTML body and any attachments, for the
+// transports that speak raw email (SMTPTransport, FileTransport,
+// StdoutTransport). Bcc recipients are intentionally omitted from the
+// rendered headers; callers are responsible for including them in the
+// envelope recipient list.
+func buildMIMEMessage(req *SendEmailRequest) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writeAddressHeader(&buf, "From", []EmailAddress{req.From})
+	writeAddressHeader(&buf, "To", req.To)
+	writeAddressHeader(&buf, "Cc", req.Cc)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", req.Subject))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+
+	writer := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", writer.Boundary())
+
+	altBody, altBoundary, err := buildAlternativeBody(req.Text, req.HTML)
+	if err != nil {
+		return nil, err
+	}
+
+	altPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {fmt.Sprintf("multipart/alternative; boundary=%s", altBoundary)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := altPart.Write(altBody); err != nil {
+		return nil, err
+	}
+
+	for _, att := range req.Attachments {
+		if err := writeAttachmentPart(writer, att); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// buildAlternativeBody renders the text/plain and text/html parts of a
+// message body and returns the multipart/alternative boundary used.
+func buildAlternativeBody(text, html string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	if text != "" {
+		if err := writeTextPart(writer, "text/plain", text); err != nil {
+			return nil, "", err
+		}
+	}
+	if html != "" {
+		if err := writeTextPart(writer, "text/html", html); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), writer.Boundary(), nil
+}
+
+func writeTextPart(writer *multipart.Writer, contentType, body string) error {
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + "; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeAttachmentPart(writer *multipart.Writer, att Attachment) error {
+	disposition := "attachment"
+	if att.Inline {
+		disposition = "inline"
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type":              {att.ContentType},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {fmt.Sprintf(`%s; filename="%s"`, disposition, att.Filename)},
+	}
+	if att.ContentID != "" {
+		header.Set("Content-ID", fmt.Sprintf("<%s>", att.ContentID))
+	}
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = part.Write([]byte(wrapBase64(base64.StdEncoding.EncodeToString(att.Content))))
+	return err
+}
+
+// wrapBase64 inserts a CRLF every 76 characters, as RFC 2045 recommends for
+// base64-encoded MIME body parts.
+func wrapBase64(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i += 76 {
+		end := i + 76
+		if end > len(s) {
+			end = len(s)
+		}
+		b.WriteString(s[i:end])
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+func writeAddressHeader(buf *bytes.Buffer, name string, addrs []EmailAddress) {
+	if len(addrs) == 0 {
+		return
+	}
+
+	parts := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		if a.Name != "" {
+			parts = append(parts, fmt.Sprintf("%s <%s>", mime.QEncoding.Encode("utf-8", a.Name), a.Email))
+		} else {
+			parts = append(parts, a.Email)
+		}
+	}
+
+	fmt.Fprintf(buf, "%s: %s\r\n", name, strings.Join(parts, ", "))
+}
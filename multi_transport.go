@@ -0,0 +1,79 @@
+package autosend
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// MultiTransport tries each Transport in order, falling back to the next
+// one when a transport fails with a server-side error or proves unreachable.
+// This lets an application degrade gracefully, for example falling back to
+// SMTP when the Autosend HTTP API is unreachable. Fallback on a dial
+// failure is safe: the prior transport never sent the message. Fallback on
+// a 5xx is an at-least-once tradeoff instead: the API returned an error,
+// but that doesn't prove it didn't also queue the message, so a 5xx can
+// still cause a duplicate send. An idempotency key passed via
+// SendEmailWithIdempotency only deduplicates against transports that honor
+// it; SMTPTransport ignores it. Only a 5xx that decodes into an *APIError
+// triggers fallback; a 5xx whose body is not a JSON error response (e.g. an
+// HTML page from a proxy) is returned as a plain error without fallback.
+type MultiTransport struct {
+	Transports []Transport
+}
+
+// NewMultiTransport creates a MultiTransport that tries transports in the
+// given order.
+func NewMultiTransport(transports ...Transport) *MultiTransport {
+	return &MultiTransport{Transports: transports}
+}
+
+// Send implements Transport. It returns the first successful response, or
+// the last error if every transport fails.
+func (t *MultiTransport) Send(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error) {
+	if len(t.Transports) == 0 {
+		return nil, errors.New("autosend: MultiTransport has no transports configured")
+	}
+
+	var lastErr error
+	for i, transport := range t.Transports {
+		resp, err := transport.Send(ctx, req, idempotencyKey)
+		if err == nil {
+			return resp, nil
+		}
+
+		lastErr = err
+		if i < len(t.Transports)-1 && !shouldFallback(err) {
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// shouldFallback reports whether err is worth falling back to the next
+// transport for, rather than surfacing immediately. Validation, auth, and
+// rate-limit errors won't be fixed by switching transports, so those
+// surface immediately. We fall back on a 5xx because the caller asked for
+// graceful degradation, not because it's provably safe: a 5xx doesn't rule
+// out the API having already queued the message, so this path accepts an
+// at-least-once tradeoff rather than a guarantee. For everything else we
+// only fall back on dial failures (DNS errors, connection refused, dial
+// timeout) that prove the request never reached the API; an error surfaced
+// after the request was written to the wire (e.g. a response timeout)
+// doesn't tell us whether the API already received and queued the
+// message, so falling back on those would risk sending it twice.
+func shouldFallback(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsServerError()
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial"
+	}
+
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
@@ -8,36 +8,69 @@ import (
 // ClientOption is a functional option for configuring the Client.
 type ClientOption func(*Client)
 
-// WithBaseURL sets a custom base URL for the API client.
+// WithBaseURL sets a custom base URL for the API client. It has no effect
+// if the client's Transport is not an *HTTPTransport, e.g. after WithTransport.
 func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) {
-		c.baseURL = baseURL
+		if t, ok := c.transport.(*HTTPTransport); ok {
+			t.BaseURL = baseURL
+		}
 	}
 }
 
-// WithHTTPClient sets a custom HTTP client.
+// WithHTTPClient sets a custom HTTP client. It has no effect if the
+// client's Transport is not an *HTTPTransport.
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Client) {
-		c.httpClient = httpClient
+		if t, ok := c.transport.(*HTTPTransport); ok {
+			t.HTTPClient = httpClient
+		}
 	}
 }
 
-// WithTimeout sets a custom timeout for HTTP requests.
+// WithTimeout sets a custom timeout for HTTP requests. It has no effect if
+// the client's Transport is not an *HTTPTransport.
 func WithTimeout(timeout time.Duration) ClientOption {
 	return func(c *Client) {
-		c.httpClient.Timeout = timeout
+		if t, ok := c.transport.(*HTTPTransport); ok {
+			t.HTTPClient.Timeout = timeout
+		}
+	}
+}
+
+// WithRateLimiter sets the RateLimiter the client consults before every
+// request and reports observed X-RateLimit-* headers to. Pass a
+// TokenBucketRateLimiter to stay under the API's ceiling proactively instead
+// of only reacting to 429s. It has no effect if the client's Transport is
+// not an *HTTPTransport.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(c *Client) {
+		if t, ok := c.transport.(*HTTPTransport); ok {
+			t.RateLimiter = limiter
+		}
+	}
+}
+
+// WithTemplateEngine sets the TemplateEngine used by Client.SendTemplated.
+func WithTemplateEngine(engine TemplateEngine) ClientOption {
+	return func(c *Client) {
+		c.templateEngine = engine
+	}
+}
+
+// WithTransport replaces the client's Transport entirely, e.g. with an
+// SMTPTransport, FileTransport, StdoutTransport, or a MultiTransport
+// combining several. Any of WithBaseURL, WithHTTPClient, WithTimeout, or
+// WithRateLimiter applied before this option are discarded.
+func WithTransport(transport Transport) ClientOption {
+	return func(c *Client) {
+		c.transport = transport
 	}
 }
 
 // NewClientWithOptions creates a new Autosend API client with functional options.
 func NewClientWithOptions(apiKey string, opts ...ClientOption) *Client {
-	client := &Client{
-		apiKey:  apiKey,
-		baseURL: DefaultBaseURL,
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
-	}
+	client := &Client{transport: NewHTTPTransport(apiKey)}
 
 	for _, opt := range opts {
 		opt(client)
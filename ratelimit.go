@@ -0,0 +1,92 @@
+package autosend
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter governs how the client paces outgoing requests. Implementations
+// must be safe for concurrent use, since SendEmailBatch may drive many
+// requests through the same Client at once.
+type RateLimiter interface {
+	// Wait blocks until the caller is clear to send another request, or
+	// until ctx is done.
+	Wait(ctx context.Context) error
+
+	// Observe updates the limiter's view of the remote rate limit from the
+	// most recently seen response. info is nil if the response carried no
+	// rate limit headers.
+	Observe(info *RateLimitInfo)
+}
+
+// noopRateLimiter is the default RateLimiter: it never delays a request, so
+// the client only reacts after the API returns a 429, matching this
+// package's behavior before adaptive rate limiting was added.
+type noopRateLimiter struct{}
+
+func (noopRateLimiter) Wait(ctx context.Context) error { return nil }
+func (noopRateLimiter) Observe(info *RateLimitInfo)    {}
+
+// TokenBucketRateLimiter is a RateLimiter that tracks the API's advertised
+// limit and pauses once the bucket is known to be empty, so callers stop
+// sending before the server starts returning 429s.
+type TokenBucketRateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// NewTokenBucketRateLimiter creates a TokenBucketRateLimiter. It starts with
+// no known limit and will not delay requests until the first response
+// carries X-RateLimit-* headers.
+func NewTokenBucketRateLimiter() *TokenBucketRateLimiter {
+	return &TokenBucketRateLimiter{}
+}
+
+// Wait blocks until the bucket has capacity or the known reset time has
+// passed, whichever comes first.
+func (l *TokenBucketRateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	wait := l.waitDurationLocked()
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *TokenBucketRateLimiter) waitDurationLocked() time.Duration {
+	if l.limit == 0 || l.remaining > 0 {
+		return 0
+	}
+	return time.Until(l.reset)
+}
+
+// Observe resizes the bucket from the most recently observed limit and
+// records how much capacity remains.
+func (l *TokenBucketRateLimiter) Observe(info *RateLimitInfo) {
+	if info == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limit = info.Limit
+	l.remaining = info.Remaining
+	if info.Reset > 0 {
+		l.reset = time.Unix(info.Reset, 0)
+	}
+}
@@ -0,0 +1,107 @@
+package autosend
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPTransport sends email through a standard SMTP server instead of the
+// Autosend HTTP API. It is useful as an on-prem fallback or for local
+// development when the Autosend API is unreachable.
+type SMTPTransport struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+
+	// STARTTLS upgrades the connection when the server advertises support
+	// for it. Defaults to true via NewSMTPTransport.
+	STARTTLS bool
+}
+
+// NewSMTPTransport creates an SMTPTransport for the given host and port
+// with STARTTLS enabled.
+func NewSMTPTransport(host string, port int) *SMTPTransport {
+	return &SMTPTransport{Host: host, Port: port, STARTTLS: true}
+}
+
+// Send implements Transport by rendering req as a MIME message and
+// delivering it over SMTP. idempotencyKey is ignored; plain SMTP has no
+// notion of idempotent delivery.
+func (t *SMTPTransport) Send(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error) {
+	msg, err := buildMIMEMessage(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build MIME message: %w", err)
+	}
+
+	var auth smtp.Auth
+	if t.Username != "" {
+		auth = smtp.PlainAuth("", t.Username, t.Password, t.Host)
+	}
+
+	recipients := make([]string, 0, len(req.To)+len(req.Cc)+len(req.Bcc))
+	for _, addrs := range [][]EmailAddress{req.To, req.Cc, req.Bcc} {
+		for _, a := range addrs {
+			recipients = append(recipients, a.Email)
+		}
+	}
+
+	if err := t.sendMail(auth, req.From.Email, recipients, msg); err != nil {
+		return nil, fmt.Errorf("smtp send failed: %w", err)
+	}
+
+	return &SendEmailResponse{
+		Success: true,
+		Message: "queued via SMTP",
+	}, nil
+}
+
+func (t *SMTPTransport) sendMail(auth smtp.Auth, from string, to []string, msg []byte) error {
+	addr := fmt.Sprintf("%s:%d", t.Host, t.Port)
+
+	client, err := smtp.Dial(addr)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if t.STARTTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: t.Host}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); ok {
+			if err := client.Auth(auth); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,45 @@
+package autosend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+)
+
+// StdoutTransport writes each message as a MIME message to an io.Writer
+// (os.Stdout by default) instead of sending it. It is intended for tests
+// and local debugging.
+type StdoutTransport struct {
+	Writer io.Writer
+}
+
+// NewStdoutTransport creates a StdoutTransport that writes to os.Stdout.
+func NewStdoutTransport() *StdoutTransport {
+	return &StdoutTransport{Writer: os.Stdout}
+}
+
+// Send implements Transport by writing req as a MIME message to t.Writer.
+func (t *StdoutTransport) Send(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error) {
+	w := t.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+
+	msg, err := buildMIMEMessage(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build MIME message: %w", err)
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return nil, fmt.Errorf("failed to write message: %w", err)
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return nil, err
+	}
+
+	return &SendEmailResponse{
+		Success: true,
+		Message: "written to stdout",
+	}, nil
+}
@@ -0,0 +1,174 @@
+package autosend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"regexp"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+)
+
+// TemplateEngine renders a named template into an email subject and body.
+type TemplateEngine interface {
+	// Render executes the template registered under name with data and
+	// returns the rendered subject, HTML body, and plaintext body. subject
+	// is empty if the template defines no "<name>.subject" block.
+	Render(name string, data any) (subject, html, text string, err error)
+}
+
+// FSTemplateEngine is a TemplateEngine backed by an fs.FS of .html and .txt
+// templates. All templates in the root directory of the filesystem are
+// parsed together (subdirectories are not searched), so a
+// template can reference a shared layout or partial defined in another file
+// via {{template "name" .}}. When a template has no matching .txt file, its
+// plaintext part is derived by stripping tags from the rendered HTML.
+//
+// A template's subject line is read from a block named "<name>.subject"
+// (e.g. "welcome.subject" for welcome.html), so each template owns its own
+// subject instead of every template sharing one global "subject" block.
+// The subject is rendered through text/template rather than html/template,
+// since a subject line isn't HTML and shouldn't have its data HTML-escaped.
+type FSTemplateEngine struct {
+	fsys  fs.FS
+	funcs template.FuncMap
+
+	mu      sync.Mutex
+	html    *template.Template
+	text    *texttemplate.Template
+	subject *texttemplate.Template
+}
+
+// NewFSTemplateEngine creates an FSTemplateEngine over fsys. funcs is made
+// available to both the html/template and text/template template sets.
+func NewFSTemplateEngine(fsys fs.FS, funcs template.FuncMap) *FSTemplateEngine {
+	return &FSTemplateEngine{fsys: fsys, funcs: funcs}
+}
+
+// Render implements TemplateEngine. name may be given with or without its
+// .html extension (e.g. "welcome" or "welcome.html").
+func (e *FSTemplateEngine) Render(name string, data any) (subject, htmlOut, textOut string, err error) {
+	htmlTmpl, textTmpl, subjectTmpl, err := e.compile()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	htmlName := name
+	if !strings.HasSuffix(htmlName, ".html") {
+		htmlName += ".html"
+	}
+
+	var htmlBuf bytes.Buffer
+	if err := htmlTmpl.ExecuteTemplate(&htmlBuf, htmlName, data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render html template %q: %w", htmlName, err)
+	}
+	htmlOut = htmlBuf.String()
+
+	subjectName := strings.TrimSuffix(htmlName, ".html") + ".subject"
+	if subjTmpl := subjectTmpl.Lookup(subjectName); subjTmpl != nil {
+		var subjBuf bytes.Buffer
+		if err := subjTmpl.Execute(&subjBuf, data); err != nil {
+			return "", "", "", fmt.Errorf("failed to render subject %q: %w", subjectName, err)
+		}
+		subject = strings.TrimSpace(subjBuf.String())
+	}
+
+	if textTmpl != nil {
+		textName := strings.TrimSuffix(htmlName, ".html") + ".txt"
+		if t := textTmpl.Lookup(textName); t != nil {
+			var textBuf bytes.Buffer
+			if err := t.Execute(&textBuf, data); err != nil {
+				return "", "", "", fmt.Errorf("failed to render text template %q: %w", textName, err)
+			}
+			textOut = textBuf.String()
+		}
+	}
+	if textOut == "" {
+		textOut = stripHTMLTags(htmlOut)
+	}
+
+	return subject, htmlOut, textOut, nil
+}
+
+// compile parses every .html and .txt template in the filesystem root once and
+// caches the result. The .html files are parsed twice: once through
+// html/template for the escaped body, and once through text/template so
+// each template's "<name>.subject" block can be rendered unescaped.
+func (e *FSTemplateEngine) compile() (*template.Template, *texttemplate.Template, *texttemplate.Template, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.html != nil {
+		return e.html, e.text, e.subject, nil
+	}
+
+	htmlFiles, err := fs.Glob(e.fsys, "*.html")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(htmlFiles) == 0 {
+		return nil, nil, nil, fmt.Errorf("no .html templates found")
+	}
+
+	htmlTmpl, err := template.New("").Funcs(e.funcs).ParseFS(e.fsys, htmlFiles...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse html templates: %w", err)
+	}
+
+	subjectTmpl, err := texttemplate.New("").Funcs(texttemplate.FuncMap(e.funcs)).ParseFS(e.fsys, htmlFiles...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse subject blocks: %w", err)
+	}
+
+	var textTmpl *texttemplate.Template
+	textFiles, err := fs.Glob(e.fsys, "*.txt")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(textFiles) > 0 {
+		textTmpl, err = texttemplate.New("").Funcs(texttemplate.FuncMap(e.funcs)).ParseFS(e.fsys, textFiles...)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse text templates: %w", err)
+		}
+	}
+
+	e.html = htmlTmpl
+	e.text = textTmpl
+	e.subject = subjectTmpl
+	return e.html, e.text, e.subject, nil
+}
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// stripHTMLTags derives a plaintext body from rendered HTML when a template
+// has no dedicated .txt counterpart.
+func stripHTMLTags(html string) string {
+	return strings.TrimSpace(htmlTagPattern.ReplaceAllString(html, ""))
+}
+
+// SendTemplated renders the named template with data using the client's
+// configured TemplateEngine and sends the result, merging the rendered
+// subject and body into a copy of base. Set WithTemplateEngine before
+// calling this method.
+func (c *Client) SendTemplated(ctx context.Context, name string, data any, base SendEmailRequest) (*SendEmailResponse, error) {
+	if c.templateEngine == nil {
+		return nil, fmt.Errorf("autosend: no TemplateEngine configured; use WithTemplateEngine")
+	}
+
+	subject, html, text, err := c.templateEngine.Render(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render template %q: %w", name, err)
+	}
+
+	req := base
+	req.HTML = html
+	req.Text = text
+	if subject != "" {
+		req.Subject = subject
+	}
+
+	return c.SendEmail(ctx, &req)
+}
@@ -0,0 +1,160 @@
+package autosend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// Transport sends a single SendEmailRequest and returns the parsed
+// response. Client.SendEmail delegates to the Client's configured
+// Transport, which defaults to the Autosend HTTP API (HTTPTransport) but
+// can be replaced with WithTransport, e.g. to send through SMTPTransport,
+// FileTransport, StdoutTransport, or a MultiTransport combining several.
+type Transport interface {
+	Send(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error)
+}
+
+// HTTPTransport sends email through the Autosend HTTP API. It is the
+// default Transport used by NewClient and NewClientWithConfig.
+type HTTPTransport struct {
+	APIKey      string
+	BaseURL     string
+	HTTPClient  *http.Client
+	RateLimiter RateLimiter
+}
+
+// NewHTTPTransport creates an HTTPTransport with the package defaults.
+func NewHTTPTransport(apiKey string) *HTTPTransport {
+	return &HTTPTransport{
+		APIKey:  apiKey,
+		BaseURL: DefaultBaseURL,
+		HTTPClient: &http.Client{
+			Timeout: DefaultTimeout,
+		},
+		RateLimiter: noopRateLimiter{},
+	}
+}
+
+// Send implements Transport by posting req to the Autosend /mails/send endpoint.
+func (t *HTTPTransport) Send(ctx context.Context, req *SendEmailRequest, idempotencyKey string) (*SendEmailResponse, error) {
+	limiter := t.RateLimiter
+	if limiter == nil {
+		limiter = noopRateLimiter{}
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limiter: %w", err)
+	}
+
+	resp, err := t.doRequest(ctx, "POST", "/mails/send", req, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var info *RateLimitInfo
+	if resp.Header.Get("X-RateLimit-Limit") != "" || resp.Header.Get("X-RateLimit-Remaining") != "" || resp.Header.Get("X-RateLimit-Reset") != "" {
+		info = parseRateLimitHeaders(resp.Header)
+	}
+	limiter.Observe(info)
+
+	if resp.StatusCode != 200 {
+		return nil, handleErrorResponse(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var emailResp SendEmailResponse
+	if err := json.Unmarshal(body, &emailResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &emailResp, nil
+}
+
+// doRequest performs an HTTP request and returns the raw response.
+func (t *HTTPTransport) doRequest(ctx context.Context, method, path string, body interface{}, idempotencyKey string) (*http.Response, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(jsonBody)
+	}
+
+	url := t.BaseURL + path
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set headers
+	req.Header.Set("Authorization", "Bearer "+t.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "autosend-go/1.0.0")
+
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := t.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// parseRateLimitHeaders extracts rate limit information from response headers.
+func parseRateLimitHeaders(headers http.Header) *RateLimitInfo {
+	info := &RateLimitInfo{}
+
+	if limit := headers.Get("X-RateLimit-Limit"); limit != "" {
+		info.Limit, _ = strconv.Atoi(limit)
+	}
+
+	if remaining := headers.Get("X-RateLimit-Remaining"); remaining != "" {
+		info.Remaining, _ = strconv.Atoi(remaining)
+	}
+
+	if reset := headers.Get("X-RateLimit-Reset"); reset != "" {
+		info.Reset, _ = strconv.ParseInt(reset, 10, 64)
+	}
+
+	return info
+}
+
+// handleErrorResponse parses and returns an appropriate error for non-2xx responses.
+func handleErrorResponse(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read error response: %w", err)
+	}
+
+	var errResp ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		// If we can't parse the error response, return a generic error
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	rateLimitInfo := parseRateLimitHeaders(resp.Header)
+
+	return &APIError{
+		StatusCode:    resp.StatusCode,
+		Message:       errResp.Message,
+		Errors:        errResp.Errors,
+		RetryAfter:    errResp.RetryAfter,
+		RateLimitInfo: rateLimitInfo,
+	}
+}
@@ -1,6 +1,10 @@
 package autosend
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 // EmailAddress represents an email address with an optional name.
 type EmailAddress struct {
@@ -8,11 +12,31 @@ type EmailAddress struct {
 	Name  string `json:"name,omitempty"`
 }
 
+// MaxAttachmentSize is the largest Content size accepted for a single
+// attachment by the Autosend API.
+const MaxAttachmentSize = 25 * 1024 * 1024 // 25MB
+
+// Attachment represents a file attached to an email. Content is
+// base64-encoded on the wire; encoding/json does this automatically for
+// []byte fields. Set Inline and ContentID together to reference the
+// attachment from HTML via cid:<ContentID>.
+type Attachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Content     []byte `json:"content"`
+	Inline      bool   `json:"inline,omitempty"`
+	ContentID   string `json:"contentId,omitempty"`
+}
+
 // SendEmailRequest represents the request body for sending an email.
 type SendEmailRequest struct {
 	// Required fields
-	To   EmailAddress `json:"to"`
-	From EmailAddress `json:"from"`
+	To   []EmailAddress `json:"to"`
+	From EmailAddress   `json:"from"`
+
+	// Additional recipients
+	Cc  []EmailAddress `json:"cc,omitempty"`
+	Bcc []EmailAddress `json:"bcc,omitempty"`
 
 	// Content fields (either HTML/Text or TemplateID required)
 	Subject    string `json:"subject,omitempty"`
@@ -20,14 +44,52 @@ type SendEmailRequest struct {
 	Text       string `json:"text,omitempty"`
 	TemplateID string `json:"templateId,omitempty"`
 
+	// Attachments to send alongside the message.
+	Attachments []Attachment `json:"attachments,omitempty"`
+
 	// Optional fields
-	ReplyTo             *EmailAddress      `json:"replyTo,omitempty"`
-	UnsubscribeGroupID  string             `json:"unsubscribeGroupId,omitempty"`
-	Categories          []string           `json:"categories,omitempty"`
-	DynamicData         map[string]any     `json:"dynamicData,omitempty"`
-	ScheduledAt         string             `json:"scheduledAt,omitempty"`
-	CampaignName        string             `json:"campaignName,omitempty"`
-	Test                bool               `json:"test,omitempty"`
+	ReplyTo            *EmailAddress  `json:"replyTo,omitempty"`
+	UnsubscribeGroupID string         `json:"unsubscribeGroupId,omitempty"`
+	Categories         []string       `json:"categories,omitempty"`
+	DynamicData        map[string]any `json:"dynamicData,omitempty"`
+	ScheduledAt        string         `json:"scheduledAt,omitempty"`
+	CampaignName       string         `json:"campaignName,omitempty"`
+	Test               bool           `json:"test,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts both the current
+// array form of "to" and the single-object form used before multi-recipient
+// support was added, so requests serialized by older versions of this
+// package still decode correctly.
+func (r *SendEmailRequest) UnmarshalJSON(data []byte) error {
+	type alias SendEmailRequest
+	aux := &struct {
+		To json.RawMessage `json:"to"`
+		*alias
+	}{
+		alias: (*alias)(r),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if len(aux.To) == 0 {
+		return nil
+	}
+
+	var list []EmailAddress
+	if err := json.Unmarshal(aux.To, &list); err == nil {
+		r.To = list
+		return nil
+	}
+
+	var single EmailAddress
+	if err := json.Unmarshal(aux.To, &single); err != nil {
+		return fmt.Errorf("to: %w", err)
+	}
+	r.To = []EmailAddress{single}
+	return nil
 }
 
 // SendEmailResponse represents the successful response from the send email API.
@@ -0,0 +1,74 @@
+// Package webhook parses and verifies Autosend webhook deliveries for
+// email lifecycle events (delivered, bounced, complained, opened, clicked).
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event types as sent in the webhook payload's "type" field.
+const (
+	TypeDelivered  = "email.delivered"
+	TypeBounced    = "email.bounced"
+	TypeComplained = "email.complained"
+	TypeOpened     = "email.opened"
+	TypeClicked    = "email.clicked"
+)
+
+// Event holds the fields common to every webhook event.
+type Event struct {
+	EmailID    string    `json:"emailId"`
+	Timestamp  time.Time `json:"timestamp"`
+	Categories []string  `json:"categories,omitempty"`
+}
+
+// DeliveredEvent is sent once the receiving mail server has accepted the message.
+type DeliveredEvent struct {
+	Event
+	Response string `json:"response,omitempty"`
+}
+
+// BouncedEvent is sent when the receiving mail server permanently or
+// temporarily rejects the message.
+type BouncedEvent struct {
+	Event
+	BounceType string `json:"bounceType"` // "hard" or "soft"
+	Reason     string `json:"reason,omitempty"`
+}
+
+// ComplainedEvent is sent when a recipient marks the message as spam.
+type ComplainedEvent struct {
+	Event
+	FeedbackType string `json:"feedbackType,omitempty"`
+}
+
+// OpenedEvent is sent when a recipient opens the message.
+type OpenedEvent struct {
+	Event
+	UserAgent string `json:"userAgent,omitempty"`
+	IP        string `json:"ip,omitempty"`
+}
+
+// ClickedEvent is sent when a recipient clicks a tracked link in the message.
+type ClickedEvent struct {
+	Event
+	URL       string `json:"url"`
+	UserAgent string `json:"userAgent,omitempty"`
+	IP        string `json:"ip,omitempty"`
+}
+
+// RawEvent is delivered to the fallback callback for event types this
+// package does not yet model, so callers can still observe and log them.
+type RawEvent struct {
+	Event
+	Type string          `json:"type"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+// envelope is the shape shared by every webhook payload, used to dispatch
+// on "type" before unmarshaling into the concrete event struct.
+type envelope struct {
+	Type string `json:"type"`
+	Event
+}
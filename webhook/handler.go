@@ -0,0 +1,293 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultSignatureHeader is the header carrying the hex-encoded
+// HMAC-SHA256 signature of "<timestamp>.<body>", where timestamp is the
+// value of the DefaultTimestampHeader header.
+const DefaultSignatureHeader = "X-Autosend-Signature"
+
+// DefaultTimestampHeader is the header carrying the Unix timestamp (seconds)
+// at which Autosend sent the webhook.
+const DefaultTimestampHeader = "X-Autosend-Timestamp"
+
+// DefaultMaxSkew is the largest allowed difference between a webhook's
+// timestamp header and the current time before Handler rejects it as a
+// possible replay.
+const DefaultMaxSkew = 5 * time.Minute
+
+// MaxBodyBytes bounds how much of a webhook request body ServeHTTP will
+// read before verifying its signature, to limit exposure to oversized
+// requests from unauthenticated callers.
+const MaxBodyBytes = 1 << 20 // 1MB
+
+// Handler verifies and dispatches Autosend webhook deliveries. It
+// implements http.Handler, so it can be registered directly with
+// http.ServeMux or mounted under a route in most third-party routers.
+type Handler struct {
+	secret          []byte
+	signatureHeader string
+	timestampHeader string
+	maxSkew         time.Duration
+
+	onDelivered  func(context.Context, *DeliveredEvent) error
+	onBounced    func(context.Context, *BouncedEvent) error
+	onComplained func(context.Context, *ComplainedEvent) error
+	onOpened     func(context.Context, *OpenedEvent) error
+	onClicked    func(context.Context, *ClickedEvent) error
+	onUnknown    func(context.Context, *RawEvent) error
+}
+
+// HandlerOption is a functional option for configuring a Handler.
+type HandlerOption func(*Handler)
+
+// WithSignatureHeader sets the header Handler reads the request signature
+// from. It defaults to DefaultSignatureHeader.
+func WithSignatureHeader(name string) HandlerOption {
+	return func(h *Handler) {
+		h.signatureHeader = name
+	}
+}
+
+// WithTimestampHeader sets the header Handler reads the delivery timestamp
+// from. It defaults to DefaultTimestampHeader.
+func WithTimestampHeader(name string) HandlerOption {
+	return func(h *Handler) {
+		h.timestampHeader = name
+	}
+}
+
+// WithMaxSkew sets how far the timestamp header may drift from the current
+// time before a request is rejected as a possible replay. It defaults to
+// DefaultMaxSkew.
+func WithMaxSkew(d time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.maxSkew = d
+	}
+}
+
+// NewHandler creates a Handler that verifies deliveries against secret. No
+// callbacks are registered by default; register them with the On* methods
+// before mounting the Handler.
+func NewHandler(secret string, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		secret:          []byte(secret),
+		signatureHeader: DefaultSignatureHeader,
+		timestampHeader: DefaultTimestampHeader,
+		maxSkew:         DefaultMaxSkew,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// OnDelivered registers the callback invoked for "email.delivered" events.
+func (h *Handler) OnDelivered(fn func(context.Context, *DeliveredEvent) error) {
+	h.onDelivered = fn
+}
+
+// OnBounced registers the callback invoked for "email.bounced" events.
+func (h *Handler) OnBounced(fn func(context.Context, *BouncedEvent) error) {
+	h.onBounced = fn
+}
+
+// OnComplained registers the callback invoked for "email.complained" events.
+func (h *Handler) OnComplained(fn func(context.Context, *ComplainedEvent) error) {
+	h.onComplained = fn
+}
+
+// OnOpened registers the callback invoked for "email.opened" events.
+func (h *Handler) OnOpened(fn func(context.Context, *OpenedEvent) error) {
+	h.onOpened = fn
+}
+
+// OnClicked registers the callback invoked for "email.clicked" events.
+func (h *Handler) OnClicked(fn func(context.Context, *ClickedEvent) error) {
+	h.onClicked = fn
+}
+
+// OnUnknown registers the callback invoked for event types this package
+// does not model as a typed struct. It is also invoked when no typed
+// callback is registered for a recognized type.
+func (h *Handler) OnUnknown(fn func(context.Context, *RawEvent) error) {
+	h.onUnknown = fn
+}
+
+// HandlerFunc adapts Handler to http.HandlerFunc, for routers (chi, gorilla
+// mux, gin's WrapF, etc.) that mount handlers by function value rather than
+// by the http.Handler interface.
+func (h *Handler) HandlerFunc() http.HandlerFunc {
+	return h.ServeHTTP
+}
+
+// ServeHTTP implements http.Handler. It verifies the request signature and
+// timestamp, parses the event, and dispatches it to the matching registered
+// callback. It responds 401 if verification fails, 400 if the body cannot
+// be parsed, 500 if a callback returns an error, and 200 otherwise.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.verify(r, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), body); err != nil {
+		status := http.StatusInternalServerError
+		var perr parseError
+		if errors.As(err, &perr) {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify checks the request's signature header against an HMAC-SHA256 of
+// the timestamp header and body, and rejects requests whose timestamp
+// header is missing or skewed by more than h.maxSkew. The timestamp must
+// be signed alongside the body, not just checked for skew: if the
+// signature covered the body alone, an attacker who captured one valid
+// (body, signature) pair could replay it forever by attaching a fresh
+// timestamp, since a body-only signature can't tell a replay from the
+// original. Binding the timestamp into the signature means a forged
+// timestamp invalidates the signature, so the skew check is what it claims
+// to be.
+func (h *Handler) verify(r *http.Request, body []byte) error {
+	ts := r.Header.Get(h.timestampHeader)
+	if ts == "" {
+		return fmt.Errorf("webhook: missing %s header", h.timestampHeader)
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("webhook: invalid %s header: %w", h.timestampHeader, err)
+	}
+
+	skew := time.Since(time.Unix(unix, 0))
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > h.maxSkew {
+		return fmt.Errorf("webhook: timestamp skew %s exceeds max of %s", skew, h.maxSkew)
+	}
+
+	sig := r.Header.Get(h.signatureHeader)
+	if sig == "" {
+		return fmt.Errorf("webhook: missing %s header", h.signatureHeader)
+	}
+
+	want, err := hex.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("webhook: malformed %s header", h.signatureHeader)
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	got := mac.Sum(nil)
+
+	if !hmac.Equal(want, got) {
+		return fmt.Errorf("webhook: signature mismatch")
+	}
+
+	return nil
+}
+
+// dispatch parses body's event type and calls the matching registered
+// callback, falling back to OnUnknown when the type is unrecognized or has
+// no callback registered.
+func (h *Handler) dispatch(ctx context.Context, body []byte) error {
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return parseError{fmt.Errorf("webhook: failed to parse event: %w", err)}
+	}
+
+	switch env.Type {
+	case TypeDelivered:
+		if h.onDelivered == nil {
+			return h.dispatchUnknown(ctx, env, body)
+		}
+		var ev DeliveredEvent
+		if err := json.Unmarshal(body, &ev); err != nil {
+			return parseError{fmt.Errorf("webhook: failed to parse %s event: %w", env.Type, err)}
+		}
+		return h.onDelivered(ctx, &ev)
+
+	case TypeBounced:
+		if h.onBounced == nil {
+			return h.dispatchUnknown(ctx, env, body)
+		}
+		var ev BouncedEvent
+		if err := json.Unmarshal(body, &ev); err != nil {
+			return parseError{fmt.Errorf("webhook: failed to parse %s event: %w", env.Type, err)}
+		}
+		return h.onBounced(ctx, &ev)
+
+	case TypeComplained:
+		if h.onComplained == nil {
+			return h.dispatchUnknown(ctx, env, body)
+		}
+		var ev ComplainedEvent
+		if err := json.Unmarshal(body, &ev); err != nil {
+			return parseError{fmt.Errorf("webhook: failed to parse %s event: %w", env.Type, err)}
+		}
+		return h.onComplained(ctx, &ev)
+
+	case TypeOpened:
+		if h.onOpened == nil {
+			return h.dispatchUnknown(ctx, env, body)
+		}
+		var ev OpenedEvent
+		if err := json.Unmarshal(body, &ev); err != nil {
+			return parseError{fmt.Errorf("webhook: failed to parse %s event: %w", env.Type, err)}
+		}
+		return h.onOpened(ctx, &ev)
+
+	case TypeClicked:
+		if h.onClicked == nil {
+			return h.dispatchUnknown(ctx, env, body)
+		}
+		var ev ClickedEvent
+		if err := json.Unmarshal(body, &ev); err != nil {
+			return parseError{fmt.Errorf("webhook: failed to parse %s event: %w", env.Type, err)}
+		}
+		return h.onClicked(ctx, &ev)
+
+	default:
+		return h.dispatchUnknown(ctx, env, body)
+	}
+}
+
+// parseError marks a dispatch failure caused by a body that could not be
+// parsed, as opposed to an error returned by a callback.
+type parseError struct{ error }
+
+func (h *Handler) dispatchUnknown(ctx context.Context, env envelope, body []byte) error {
+	if h.onUnknown == nil {
+		return nil
+	}
+	return h.onUnknown(ctx, &RawEvent{Event: env.Event, Type: env.Type, Raw: body})
+}